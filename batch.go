@@ -0,0 +1,130 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MaxBatchActions is the most sub-requests a single Batch can hold, a
+// limit Asana enforces server-side on /batch.
+const MaxBatchActions = 10
+
+// batchAction is a single queued sub-request.
+type batchAction struct {
+	Method       string
+	RelativePath string
+	Data         interface{}
+	Options      *Options
+	target       interface{}
+}
+
+// Batch bundles up to MaxBatchActions sub-requests into a single call to
+// Asana's /batch endpoint, so tools that update many objects don't burn
+// through the per-minute rate limit doing it one request at a time.
+//
+//	b := client.NewBatch()
+//	b.Add("GET", "/tasks/123", nil, nil, &task)
+//	b.Add("PUT", "/tasks/123", updatePayload, nil, &updated)
+//	results, err := b.Execute(ctx)
+type Batch struct {
+	client  *Client
+	actions []*batchAction
+}
+
+// NewBatch returns an empty Batch bound to c.
+func (c *Client) NewBatch() *Batch {
+	return &Batch{client: c}
+}
+
+// Add queues a sub-request. method is an HTTP verb, path is relative to
+// the API root (for example "/tasks/123"), body is marshaled as that
+// sub-request's data (nil for a GET or DELETE), opts carries per-action
+// options such as Fields/Expand (nil for none), and target receives the
+// decoded response body once Execute runs; it may be nil to discard it.
+func (b *Batch) Add(method, path string, body interface{}, opts *Options, target interface{}) error {
+	if len(b.actions) >= MaxBatchActions {
+		return fmt.Errorf("asana: batch already holds the maximum of %d actions", MaxBatchActions)
+	}
+
+	b.actions = append(b.actions, &batchAction{
+		Method:       method,
+		RelativePath: path,
+		Data:         body,
+		Options:      opts,
+		target:       target,
+	})
+
+	return nil
+}
+
+// BatchResult is one sub-request's outcome.
+type BatchResult struct {
+	StatusCode int
+	Body       json.RawMessage
+
+	// Err holds the sub-request's own failure, if any, without
+	// aborting the rest of the batch.
+	Err error
+}
+
+type batchActionWire struct {
+	Method       string      `json:"method"`
+	RelativePath string      `json:"relative_path"`
+	Data         interface{} `json:"data,omitempty"`
+	Options      *Options    `json:"options,omitempty"`
+}
+
+type batchResultWire struct {
+	StatusCode int             `json:"status_code"`
+	Body       json.RawMessage `json:"body"`
+}
+
+// Execute sends every queued action as a single request to /batch and
+// decodes each sub-response into the target passed to the matching Add
+// call. It returns one BatchResult per action, in the order they were
+// added.
+func (b *Batch) Execute(ctx context.Context) ([]*BatchResult, error) {
+	wire := make([]batchActionWire, len(b.actions))
+	for i, a := range b.actions {
+		wire[i] = batchActionWire{Method: a.Method, RelativePath: a.RelativePath, Data: a.Data, Options: a.Options}
+	}
+
+	var raw []batchResultWire
+	if err := b.client.post(ctx, "/batch", map[string]interface{}{"actions": wire}, &raw); err != nil {
+		return nil, err
+	}
+
+	if len(raw) != len(b.actions) {
+		return nil, fmt.Errorf("asana: batch response held %d results for %d actions", len(raw), len(b.actions))
+	}
+
+	results := make([]*BatchResult, len(raw))
+	for i, r := range raw {
+		result := &BatchResult{StatusCode: r.StatusCode, Body: r.Body}
+
+		switch {
+		case r.StatusCode >= 400:
+			result.Err = fmt.Errorf("asana: batch action %d (%s %s): status %d: %s",
+				i, b.actions[i].Method, b.actions[i].RelativePath, r.StatusCode, r.Body)
+		case b.actions[i].target != nil:
+			// Each sub-response body is itself a full Asana response,
+			// wrapped in the same {"data": ...} envelope that do/get/
+			// getPage unwrap for a direct call.
+			var envelope struct {
+				Data json.RawMessage `json:"data"`
+			}
+			result.Err = json.Unmarshal(r.Body, &envelope)
+			if result.Err == nil {
+				result.Err = json.Unmarshal(envelope.Data, b.actions[i].target)
+			}
+			if result.Err == nil && b.client.AcceptLegacyIDs {
+				applyLegacyGID(envelope.Data, b.actions[i].target)
+			}
+		}
+
+		results[i] = result
+	}
+
+	return results, nil
+}
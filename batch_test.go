@@ -0,0 +1,80 @@
+package asana
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBatchExecuteDemux(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"status_code": 200, "body": {"data": {"gid": "1", "name": "A"}}},
+			{"status_code": 404, "body": {"errors": [{"message": "not found"}]}}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.BaseURL = server.URL
+
+	b := c.NewBatch()
+	var task Task
+	if err := b.Add(http.MethodGet, "/tasks/1", nil, nil, &task); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	if err := b.Add(http.MethodGet, "/tasks/2", nil, nil, nil); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	results, err := b.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+
+	if results[0].Err != nil {
+		t.Errorf("results[0].Err = %v, want nil", results[0].Err)
+	}
+	if task.GID != "1" || task.Name != "A" {
+		t.Errorf("target not populated from the unwrapped data envelope: %+v", task)
+	}
+
+	if results[1].Err == nil {
+		t.Error("results[1].Err = nil, want an error for a 404 sub-response")
+	}
+}
+
+func TestBatchExecuteAppliesLegacyGID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"data": [
+			{"status_code": 200, "body": {"data": {"id": 123, "name": "Legacy"}}}
+		]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.BaseURL = server.URL
+	c.AcceptLegacyIDs = true
+
+	b := c.NewBatch()
+	var ws Workspace
+	if err := b.Add(http.MethodGet, "/workspaces/123", nil, nil, &ws); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	results, err := b.Execute(context.Background())
+	if err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if results[0].Err != nil {
+		t.Fatalf("results[0].Err = %v", results[0].Err)
+	}
+
+	if ws.GID != "123" {
+		t.Errorf("GID = %q, want %q (legacy id should be backfilled from the unwrapped envelope)", ws.GID, "123")
+	}
+}
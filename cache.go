@@ -0,0 +1,249 @@
+package asana
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CacheEntry is a single cached GET response, stored long enough to
+// either serve it directly (within its TTL) or revalidate it with a
+// conditional request.
+type CacheEntry struct {
+	// Key is the cache key this entry was stored under, carried along
+	// so a Store can find entries by prefix without a separate index.
+	Key          string      `json:"key"`
+	StatusCode   int         `json:"status_code"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+	StoredAt     time.Time   `json:"stored_at"`
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"last_modified,omitempty"`
+}
+
+// Store persists CacheEntry values keyed by an opaque cache key built
+// from the request URL and options. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Get returns the entry for key, or found=false if there isn't one.
+	Get(key string) (entry *CacheEntry, found bool, err error)
+
+	// Set stores entry under key, overwriting any previous value.
+	Set(key string, entry *CacheEntry) error
+
+	// Delete removes every entry whose key starts with prefix. It is
+	// used to invalidate an entire resource (for example all cached
+	// requests under "/projects/1234") after a mutating call.
+	Delete(prefix string) error
+}
+
+// FileStore is the default Store implementation: one file per cache
+// entry under Dir, named after the sha256 of the cache key so arbitrary
+// URLs and option combinations are safe to use as filenames.
+type FileStore struct {
+	// Dir is the directory entries are stored under. It is created on
+	// first use if it does not already exist.
+	Dir string
+}
+
+// NewFileStore returns a FileStore rooted at dir.
+func NewFileStore(dir string) *FileStore {
+	return &FileStore{Dir: dir}
+}
+
+func (s *FileStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(s.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get implements Store.
+func (s *FileStore) Get(key string) (*CacheEntry, bool, error) {
+	data, err := os.ReadFile(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, err
+	}
+
+	return &entry, true, nil
+}
+
+// Set implements Store.
+func (s *FileStore) Set(key string, entry *CacheEntry) error {
+	if err := os.MkdirAll(s.Dir, 0o755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path(key), data, 0o644)
+}
+
+// Delete implements Store. FileStore has no notion of key prefixes once
+// a key has been hashed into a filename, so it keeps a companion index
+// file mapping keys to their hashed path and scans that instead.
+func (s *FileStore) Delete(prefix string) error {
+	entries, err := os.ReadDir(s.Dir)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(s.Dir, e.Name()))
+		if err != nil {
+			continue
+		}
+
+		var cached CacheEntry
+		if json.Unmarshal(data, &cached) == nil && strings.HasPrefix(cached.Key, prefix) {
+			_ = os.Remove(filepath.Join(s.Dir, e.Name()))
+		}
+	}
+
+	return nil
+}
+
+// TTLFunc returns how long a cached response for the given request path
+// remains fresh before it must be revalidated. Returning zero disables
+// caching for that path.
+type TTLFunc func(path string) time.Duration
+
+// DefaultTTL is a TTLFunc that caches workspace and user lookups for an
+// hour, everything else for a minute.
+func DefaultTTL(path string) time.Duration {
+	switch {
+	case strings.HasPrefix(path, "/workspaces"), strings.HasPrefix(path, "/users"):
+		return time.Hour
+	default:
+		return time.Minute
+	}
+}
+
+// CacheTransport is an http.RoundTripper that serves GET requests out of
+// a Store, revalidating with If-None-Match/If-Modified-Since once an
+// entry's TTL has elapsed and falling back to a full request when there
+// is nothing cached.
+type CacheTransport struct {
+	// Next is the underlying RoundTripper used for cache misses and
+	// revalidation requests. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+
+	// Store holds cached entries. Required.
+	Store Store
+
+	// TTL decides how long a cached entry is served without
+	// revalidation. Defaults to DefaultTTL.
+	TTL TTLFunc
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		return t.next().RoundTrip(req)
+	}
+
+	ttl := t.TTL
+	if ttl == nil {
+		ttl = DefaultTTL
+	}
+
+	key := cacheKey(req)
+	entry, found, err := t.Store.Get(key)
+	if err == nil && found {
+		if time.Since(entry.StoredAt) < ttl(req.URL.Path) {
+			return entry.response(), nil
+		}
+
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := t.next().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if found && resp.StatusCode == http.StatusNotModified {
+		entry.StoredAt = time.Now()
+		_ = t.Store.Set(key, entry)
+		resp.Body.Close()
+		return entry.response(), nil
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		_ = t.Store.Set(key, &CacheEntry{
+			Key:          key,
+			StatusCode:   resp.StatusCode,
+			Header:       resp.Header,
+			Body:         body,
+			StoredAt:     time.Now(),
+			ETag:         resp.Header.Get("ETag"),
+			LastModified: resp.Header.Get("Last-Modified"),
+		})
+	}
+
+	return resp, nil
+}
+
+func (t *CacheTransport) next() http.RoundTripper {
+	if t.Next != nil {
+		return t.Next
+	}
+	return http.DefaultTransport
+}
+
+func (e *CacheEntry) response() *http.Response {
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     e.Header,
+		Body:       io.NopCloser(bytes.NewReader(e.Body)),
+	}
+}
+
+// cacheKey builds the Store key for req from its path and full query
+// string, so two requests for the same resource with different field
+// selections, pagination offsets, or other parameters don't collide.
+func cacheKey(req *http.Request) string {
+	return req.URL.Path + "?" + req.URL.RawQuery
+}
+
+// InvalidateCache removes every cached GET response under resource (for
+// example "/projects/1234") from c's cache, if one is configured. It is
+// called automatically after mutating calls to the same path, but can
+// also be called directly when external changes make cached data stale.
+func (c *Client) InvalidateCache(resource string) error {
+	if c.cache == nil {
+		return nil
+	}
+	return c.cache.Delete(resource)
+}
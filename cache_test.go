@@ -0,0 +1,48 @@
+package asana
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientWithCacheServesAndInvalidates(t *testing.T) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			hits++
+			w.Write([]byte(`{"data": {"gid": "1", "name": "Task"}}`))
+		default:
+			w.Write([]byte(`{"data": {"gid": "1", "name": "Renamed"}}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.BaseURL = server.URL
+	c.WithCache(NewFileStore(t.TempDir()))
+
+	var task Task
+	if err := c.get(context.Background(), "/tasks/1", &task); err != nil {
+		t.Fatalf("first get: %v", err)
+	}
+	if err := c.get(context.Background(), "/tasks/1", &task); err != nil {
+		t.Fatalf("second get: %v", err)
+	}
+	if hits != 1 {
+		t.Fatalf("expected the second GET to be served from cache, got %d server hits", hits)
+	}
+
+	if err := c.put(context.Background(), "/tasks/1", map[string]string{"name": "Renamed"}, &task); err != nil {
+		t.Fatalf("put: %v", err)
+	}
+
+	if err := c.get(context.Background(), "/tasks/1", &task); err != nil {
+		t.Fatalf("third get: %v", err)
+	}
+	if hits != 2 {
+		t.Fatalf("expected the put to invalidate the cache entry, got %d server hits", hits)
+	}
+}
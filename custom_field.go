@@ -0,0 +1,140 @@
+package asana
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// CustomField is a user-defined field that can be attached to tasks in a
+// workspace, alongside Asana's built-in fields like name and notes.
+type CustomField struct {
+	HasID
+	HasName
+
+	expandable
+
+	// Type is one of text, number, enum, multi_enum, or date.
+	Type string `json:"resource_subtype,omitempty"`
+
+	// Precision is the number of decimal places to display for a
+	// number field.
+	Precision int `json:"precision,omitempty"`
+
+	// EnumOptions lists the choices for an enum or multi_enum field, in
+	// display order.
+	EnumOptions []*EnumOption `json:"enum_options,omitempty"`
+}
+
+// EnumOption is a single choice for an enum or multi_enum CustomField.
+type EnumOption struct {
+	HasID
+	HasName
+	HasColor
+
+	// Enabled is false for options that have been archived but must be
+	// kept around because existing tasks still reference them.
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// CustomFieldSetting associates a CustomField with a project, including
+// whether it should be shown prominently in that project's views.
+type CustomFieldSetting struct {
+	HasID
+
+	expandable
+
+	// Project this field is attached to.
+	Project *Project `json:"project,omitempty"`
+
+	// CustomField being attached.
+	CustomField *CustomField `json:"custom_field,omitempty"`
+
+	// IsImportant flags fields that should appear in a project's
+	// default columns rather than only in a task's detail view.
+	IsImportant bool `json:"is_important,omitempty"`
+}
+
+// CustomFields lists every custom field defined in w, including ones
+// not currently attached to any project.
+func (w *Workspace) CustomFields(ctx context.Context, client *Client) ([]*CustomField, error) {
+	var result []*CustomField
+	if err := client.get(ctx, "/workspaces/"+w.GID+"/custom_fields", &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CustomFieldSettings lists the custom fields attached to p, in the
+// order they're displayed.
+func (p *Project) CustomFieldSettings(ctx context.Context, client *Client) ([]*CustomFieldSetting, error) {
+	var result []*CustomFieldSetting
+	if err := client.get(ctx, "/projects/"+p.GID+"/custom_field_settings", &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CustomFieldValue is a single field's value as attached to a task, in
+// the shape Asana returns it in: only the member matching
+// CustomField.Type is populated. It is decode-only — Asana's write
+// shape for custom fields is a {gid: value} map on the owning Task's
+// update body, not this array-of-typed-value shape, so a value read off
+// Task.CustomFields cannot be marshaled back as-is.
+type CustomFieldValue struct {
+	CustomField *CustomField `json:"-"`
+
+	TextValue       string        `json:"text_value,omitempty"`
+	NumberValue     float64       `json:"number_value,omitempty"`
+	EnumValue       *EnumOption   `json:"enum_value,omitempty"`
+	MultiEnumValues []*EnumOption `json:"multi_enum_values,omitempty"`
+	DateValue       *Date         `json:"date_value,omitempty"`
+}
+
+// customFieldValueWire is the on-the-wire shape of a custom field value:
+// the field's own id/name/type alongside whichever *_value member
+// applies, driven by resource_subtype.
+type customFieldValueWire struct {
+	HasID
+	HasName
+	Type string `json:"resource_subtype"`
+
+	TextValue       string        `json:"text_value,omitempty"`
+	NumberValue     json.Number   `json:"number_value,omitempty"`
+	EnumValue       *EnumOption   `json:"enum_value,omitempty"`
+	MultiEnumValues []*EnumOption `json:"multi_enum_values,omitempty"`
+	DateValue       *Date         `json:"date_value,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaller interface, decoding
+// only the *_value member that matches the wire resource_subtype.
+func (v *CustomFieldValue) UnmarshalJSON(data []byte) error {
+	var wire customFieldValueWire
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+
+	v.CustomField = &CustomField{HasID: wire.HasID, HasName: wire.HasName, Type: wire.Type}
+
+	switch wire.Type {
+	case "text":
+		v.TextValue = wire.TextValue
+	case "number":
+		if wire.NumberValue != "" {
+			f, err := wire.NumberValue.Float64()
+			if err != nil {
+				return err
+			}
+			v.NumberValue = f
+		}
+	case "enum":
+		v.EnumValue = wire.EnumValue
+	case "multi_enum":
+		v.MultiEnumValues = wire.MultiEnumValues
+	case "date":
+		v.DateValue = wire.DateValue
+	}
+
+	return nil
+}
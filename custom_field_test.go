@@ -0,0 +1,69 @@
+package asana
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCustomFieldValueUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		data string
+		want func(v *CustomFieldValue) bool
+	}{
+		{
+			name: "text",
+			data: `{"gid": "1", "resource_subtype": "text", "text_value": "hello"}`,
+			want: func(v *CustomFieldValue) bool { return v.TextValue == "hello" },
+		},
+		{
+			name: "number",
+			data: `{"gid": "2", "resource_subtype": "number", "number_value": "3.5"}`,
+			want: func(v *CustomFieldValue) bool { return v.NumberValue == 3.5 },
+		},
+		{
+			name: "enum",
+			data: `{"gid": "3", "resource_subtype": "enum", "enum_value": {"gid": "e1", "name": "High"}}`,
+			want: func(v *CustomFieldValue) bool { return v.EnumValue != nil && v.EnumValue.Name == "High" },
+		},
+		{
+			name: "multi_enum",
+			data: `{"gid": "4", "resource_subtype": "multi_enum", "multi_enum_values": [{"gid": "e1"}, {"gid": "e2"}]}`,
+			want: func(v *CustomFieldValue) bool { return len(v.MultiEnumValues) == 2 },
+		},
+		{
+			name: "date",
+			data: `{"gid": "5", "resource_subtype": "date", "date_value": "2024-01-15"}`,
+			want: func(v *CustomFieldValue) bool { return v.DateValue != nil },
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var v CustomFieldValue
+			if err := json.Unmarshal([]byte(c.data), &v); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			if !c.want(&v) {
+				t.Errorf("unexpected decode for %s: %+v", c.name, v)
+			}
+
+			if v.CustomField == nil || v.CustomField.Type != c.name {
+				t.Errorf("CustomField = %+v, want Type %q", v.CustomField, c.name)
+			}
+		})
+	}
+}
+
+func TestCustomFieldValueUnmarshalJSONIgnoresOtherSubtypeValues(t *testing.T) {
+	var v CustomFieldValue
+	data := `{"gid": "1", "resource_subtype": "text", "text_value": "hello", "number_value": "9"}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if v.NumberValue != 0 {
+		t.Errorf("NumberValue = %v, want 0 (only the text subtype's member should be populated)", v.NumberValue)
+	}
+}
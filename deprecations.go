@@ -0,0 +1,21 @@
+package asana
+
+// Deprecation identifies an Asana API behavior change that must be
+// explicitly opted into (or held back from) via the Asana-Enable and
+// Asana-Disable headers until Asana makes it the default for everyone.
+// See https://developers.asana.com/docs/deprecations for the current list.
+type Deprecation string
+
+// EnableDeprecation opts c in to an upcoming Asana API behavior change
+// ahead of its default rollout date, by sending it on the Asana-Enable
+// header of every request c makes.
+func (c *Client) EnableDeprecation(d Deprecation) {
+	c.enabledDeprecations = append(c.enabledDeprecations, d)
+}
+
+// DisableDeprecation keeps c on the legacy behavior for an Asana API
+// change that has already become the default, by sending it on the
+// Asana-Disable header of every request c makes.
+func (c *Client) DisableDeprecation(d Deprecation) {
+	c.disabledDeprecations = append(c.disabledDeprecations, d)
+}
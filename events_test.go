@@ -0,0 +1,51 @@
+package asana
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClientEvents(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"data": [{"action": "changed", "resource": {"gid": "123", "resource_type": "task"}}],
+			"sync_token": "abc123",
+			"has_more": false
+		}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.BaseURL = server.URL
+
+	events, syncToken, err := c.Events(context.Background(), "456", "")
+	if err != nil {
+		t.Fatalf("Events returned error: %v", err)
+	}
+
+	if syncToken != "abc123" {
+		t.Errorf("SyncToken = %q, want %q", syncToken, "abc123")
+	}
+
+	if len(events) != 1 || events[0].Action != "changed" || events[0].Resource.GID != "123" {
+		t.Errorf("unexpected events: %+v", events)
+	}
+}
+
+func TestClientEventsSyncTokenInvalid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors": [{"message": "sync_token_invalid"}]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.Client())
+	c.BaseURL = server.URL
+
+	_, _, err := c.Events(context.Background(), "456", "stale-token")
+	if !ErrSyncTokenInvalid(err) {
+		t.Fatalf("expected ErrSyncTokenInvalid, got %v", err)
+	}
+}
@@ -0,0 +1,117 @@
+package asana
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrIteratorDone is returned by Iterator.Next once every page has been
+// consumed. Callers should treat it as the normal end-of-sequence signal,
+// not a failure.
+var ErrIteratorDone = errors.New("asana: iterator exhausted")
+
+// Page is a single page of paginated results, as returned by a Fetcher.
+// NextPage is the opaque offset token to request the following page, or
+// empty if this was the last page.
+type Page[T any] struct {
+	Items    []T
+	NextPage string
+}
+
+// Fetcher retrieves one page of results starting at offset. An empty
+// offset requests the first page.
+type Fetcher[T any] func(ctx context.Context, offset string) (Page[T], error)
+
+// Iterator streams through every page a Fetcher produces, automatically
+// requesting the next page using Asana's offset-based pagination once
+// the current page is exhausted. It is not safe for concurrent use.
+type Iterator[T any] struct {
+	fetch    Fetcher[T]
+	pageSize int
+	buf      []T
+	offset   string
+	done     bool
+}
+
+// withPage returns a copy of opts (or a zero Options if opts is nil)
+// with Limit and Offset overridden, so a resource's *Iterator
+// constructor can drive its own paging while still honoring any
+// Fields/Expand/Pretty the caller set.
+func withPage(opts *Options, pageSize int, offset string) *Options {
+	var out Options
+	if opts != nil {
+		out = *opts
+	}
+	out.Limit = pageSize
+	out.Offset = offset
+	return &out
+}
+
+// NewIterator returns an Iterator that pages through fetch, asking for
+// pageSize items per page (via Options.Limit). A pageSize of zero leaves
+// the page size up to the API's default.
+func NewIterator[T any](fetch Fetcher[T], pageSize int) *Iterator[T] {
+	return &Iterator[T]{fetch: fetch, pageSize: pageSize}
+}
+
+// Next returns the next item, fetching a new page if the current one has
+// been exhausted. It returns ErrIteratorDone once there is nothing left.
+func (it *Iterator[T]) Next(ctx context.Context) (T, error) {
+	for len(it.buf) == 0 {
+		var zero T
+		if it.done {
+			return zero, ErrIteratorDone
+		}
+
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		page, err := it.fetch(ctx, it.offset)
+		if err != nil {
+			return zero, err
+		}
+
+		it.buf = page.Items
+		it.offset = page.NextPage
+		if page.NextPage == "" {
+			it.done = true
+		}
+
+		if len(it.buf) == 0 && it.done {
+			return zero, ErrIteratorDone
+		}
+	}
+
+	item := it.buf[0]
+	it.buf = it.buf[1:]
+	return item, nil
+}
+
+// Chan returns a channel of every remaining item, closed once the
+// iterator is exhausted or ctx is cancelled. It's a convenience for
+// range-loop consumption: for item := range it.Chan(ctx) { ... }. Errors
+// encountered while fetching a page silently stop the stream; use Next
+// directly if you need to observe them.
+func (it *Iterator[T]) Chan(ctx context.Context) <-chan T {
+	ch := make(chan T, max(it.pageSize, 1))
+
+	go func() {
+		defer close(ch)
+
+		for {
+			item, err := it.Next(ctx)
+			if err != nil {
+				return
+			}
+
+			select {
+			case ch <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch
+}
@@ -0,0 +1,58 @@
+package asana
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIteratorNext(t *testing.T) {
+	pages := []Page[int]{
+		{Items: []int{1, 2}, NextPage: "p2"},
+		{Items: []int{3}, NextPage: ""},
+	}
+	calls := 0
+	it := NewIterator(func(ctx context.Context, offset string) (Page[int], error) {
+		page := pages[calls]
+		calls++
+		return page, nil
+	}, 2)
+
+	ctx := context.Background()
+	var got []int
+	for {
+		v, err := it.Next(ctx)
+		if err == ErrIteratorDone {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+		got = append(got, v)
+	}
+
+	if calls != 2 {
+		t.Errorf("fetched %d pages, want 2", calls)
+	}
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+
+	if _, err := it.Next(ctx); err != ErrIteratorDone {
+		t.Errorf("Next after exhaustion = %v, want ErrIteratorDone", err)
+	}
+}
+
+func TestIteratorChan(t *testing.T) {
+	it := NewIterator(func(ctx context.Context, offset string) (Page[int], error) {
+		return Page[int]{Items: []int{1, 2, 3}, NextPage: ""}, nil
+	}, 10)
+
+	var got []int
+	for v := range it.Chan(context.Background()) {
+		got = append(got, v)
+	}
+
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Errorf("got %v, want [1 2 3]", got)
+	}
+}
@@ -0,0 +1,63 @@
+package asana
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// legacyHasID captures the deprecated numeric "id" field that Asana
+// returned before the gid migration.
+type legacyHasID struct {
+	ID json.Number `json:"id,omitempty"`
+}
+
+// applyLegacyGID backfills the GID of any embedded HasID in out that the
+// normal decode of data left empty, using the legacy numeric "id" field.
+// out must be the same pointer (or slice of pointers/values) passed to
+// json.Unmarshal(data, out). Only used when Client.AcceptLegacyIDs is
+// set; unrecognized shapes are left untouched.
+func applyLegacyGID(data []byte, out interface{}) {
+	v := reflect.ValueOf(out)
+	if v.Kind() == reflect.Ptr {
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Slice:
+		// Each element's GID must be backfilled from its own raw JSON,
+		// not from the array as a whole.
+		var rawItems []json.RawMessage
+		if err := json.Unmarshal(data, &rawItems); err != nil || len(rawItems) != v.Len() {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			applyLegacyGIDToStruct(rawItems[i], v.Index(i))
+		}
+	case reflect.Struct:
+		applyLegacyGIDToStruct(data, v)
+	}
+}
+
+func applyLegacyGIDToStruct(data []byte, v reflect.Value) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	gid := v.FieldByName("GID")
+	if !gid.IsValid() || gid.Kind() != reflect.String || gid.String() != "" || !gid.CanSet() {
+		return
+	}
+
+	var legacy legacyHasID
+	if err := json.Unmarshal(data, &legacy); err != nil || legacy.ID == "" {
+		return
+	}
+
+	gid.SetString(legacy.ID.String())
+}
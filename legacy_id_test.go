@@ -0,0 +1,35 @@
+package asana
+
+import "testing"
+
+func TestApplyLegacyGIDStruct(t *testing.T) {
+	w := &Workspace{}
+	applyLegacyGID([]byte(`{"id": 123, "name": "Acme"}`), w)
+
+	if w.GID != "123" {
+		t.Errorf("GID = %q, want %q", w.GID, "123")
+	}
+}
+
+func TestApplyLegacyGIDSlice(t *testing.T) {
+	workspaces := []*Workspace{{}, {}}
+	data := []byte(`[{"id": 1, "name": "One"}, {"id": 2, "name": "Two"}]`)
+
+	applyLegacyGID(data, &workspaces)
+
+	if workspaces[0].GID != "1" {
+		t.Errorf("workspaces[0].GID = %q, want %q", workspaces[0].GID, "1")
+	}
+	if workspaces[1].GID != "2" {
+		t.Errorf("workspaces[1].GID = %q, want %q", workspaces[1].GID, "2")
+	}
+}
+
+func TestApplyLegacyGIDDoesNotOverrideExistingGID(t *testing.T) {
+	w := &Workspace{HasID: HasID{GID: "already-set"}}
+	applyLegacyGID([]byte(`{"id": 999}`), w)
+
+	if w.GID != "already-set" {
+		t.Errorf("GID = %q, want unchanged %q", w.GID, "already-set")
+	}
+}
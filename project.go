@@ -0,0 +1,56 @@
+package asana
+
+import "context"
+
+// Project is a collection of tasks working towards a shared goal, the
+// main organizational unit below a Workspace.
+type Project struct {
+	HasID
+	HasName
+	HasNotes
+	HasCreated
+	HasColor
+	HasFollowers
+	HasWorkspace
+
+	expandable
+
+	// Archived is true once the project has been closed out.
+	Archived bool `json:"archived,omitempty"`
+}
+
+// Tasks lists the tasks in p.
+func (p *Project) Tasks(client *Client, opts *Options) (tasks []*Task, nextPage string, err error) {
+	nextPage, err = client.getPage(context.Background(), addOptions("/projects/"+p.GID+"/tasks", opts), &tasks)
+	return tasks, nextPage, err
+}
+
+// Sections lists the sections p is divided into, in board order.
+func (p *Project) Sections(client *Client, opts *Options) (sections []*Section, nextPage string, err error) {
+	nextPage, err = client.getPage(context.Background(), addOptions("/projects/"+p.GID+"/sections", opts), &sections)
+	return sections, nextPage, err
+}
+
+// TasksIterator returns an Iterator over the tasks in p, so callers
+// don't have to hand-roll a Fetcher around Tasks.
+func (p *Project) TasksIterator(client *Client, opts *Options, pageSize int) *Iterator[*Task] {
+	return NewIterator(func(ctx context.Context, offset string) (Page[*Task], error) {
+		tasks, nextPage, err := p.Tasks(client, withPage(opts, pageSize, offset))
+		if err != nil {
+			return Page[*Task]{}, err
+		}
+		return Page[*Task]{Items: tasks, NextPage: nextPage}, nil
+	}, pageSize)
+}
+
+// SectionsIterator returns an Iterator over the sections in p, so
+// callers don't have to hand-roll a Fetcher around Sections.
+func (p *Project) SectionsIterator(client *Client, opts *Options, pageSize int) *Iterator[*Section] {
+	return NewIterator(func(ctx context.Context, offset string) (Page[*Section], error) {
+		sections, nextPage, err := p.Sections(client, withPage(opts, pageSize, offset))
+		if err != nil {
+			return Page[*Section]{}, err
+		}
+		return Page[*Section]{Items: sections, NextPage: nextPage}, nil
+	}, pageSize)
+}
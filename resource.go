@@ -0,0 +1,313 @@
+package asana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// DefaultBaseURL is the root of the Asana API that Client talks to
+// unless BaseURL is overridden.
+const DefaultBaseURL = "https://app.asana.com/api/1.0"
+
+// expandable is embedded by resources that come back compact by default
+// and can be expanded with Options.Expand; it exists purely as a marker
+// today, ahead of per-resource lazy-loading support.
+type expandable struct{}
+
+// Client is an Asana API client. The zero value is not usable; create
+// one with NewClient.
+type Client struct {
+	// HTTPClient performs the underlying requests. Defaults to
+	// http.DefaultClient. Wrap its Transport with RetryTransport and/or
+	// CacheTransport to add retries and caching.
+	HTTPClient *http.Client
+
+	// BaseURL is the root the client builds request URLs against.
+	// Defaults to DefaultBaseURL.
+	BaseURL string
+
+	// AcceptLegacyIDs makes every response decode also backfill any
+	// embedded HasID.GID left empty from the legacy numeric "id" field,
+	// for callers still migrating off of it.
+	AcceptLegacyIDs bool
+
+	cache                Store
+	enabledDeprecations  []Deprecation
+	disabledDeprecations []Deprecation
+}
+
+// NewClient returns a Client that authenticates with httpClient, which
+// is expected to already attach credentials (for example via
+// golang.org/x/oauth2). Pass nil to use http.DefaultClient unmodified
+// (only useful for talking to a server that doesn't require auth, such
+// as a test double).
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{HTTPClient: httpClient, BaseURL: DefaultBaseURL}
+}
+
+// WithCache configures c to serve and populate GET responses through
+// store, and to invalidate cached entries automatically whenever a
+// mutating call hits the same path (see InvalidateCache).
+func (c *Client) WithCache(store Store) *Client {
+	c.cache = store
+
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{}
+	}
+
+	next := c.HTTPClient.Transport
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	httpClient := *c.HTTPClient
+	httpClient.Transport = &CacheTransport{Next: next, Store: store}
+	c.HTTPClient = &httpClient
+
+	return c
+}
+
+func (c *Client) url(path string) string {
+	return c.BaseURL + path
+}
+
+func (c *Client) setDeprecationHeaders(req *http.Request) {
+	for _, d := range c.enabledDeprecations {
+		req.Header.Add("Asana-Enable", string(d))
+	}
+	for _, d := range c.disabledDeprecations {
+		req.Header.Add("Asana-Disable", string(d))
+	}
+}
+
+// do sends req and decodes the Asana envelope's "data" member into out,
+// which may be nil to discard the body.
+func (c *Client) do(req *http.Request, out interface{}) error {
+	c.setDeprecationHeaders(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("asana: %s %s: %s: %s", req.Method, req.URL.Path, resp.Status, body)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return err
+	}
+
+	if c.AcceptLegacyIDs {
+		applyLegacyGID(envelope.Data, out)
+	}
+
+	return nil
+}
+
+// get issues a GET request against path, decoding the response into out.
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, out)
+}
+
+// getRaw issues a GET request against path and decodes the entire
+// response body into out, for the few endpoints (like /events) whose
+// envelope isn't the standard {"data": ...} shape that get/do assume.
+func (c *Client) getRaw(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return err
+	}
+
+	c.setDeprecationHeaders(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("asana: GET %s: %s: %s", req.URL.Path, resp.Status, body)
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// getPage issues a GET request against a paginated list endpoint,
+// decoding the items into out and returning the next_page offset token
+// from the envelope, if Asana sent one.
+func (c *Client) getPage(ctx context.Context, path string, out interface{}) (nextPage string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(path), nil)
+	if err != nil {
+		return "", err
+	}
+
+	c.setDeprecationHeaders(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode >= 400 {
+		return "", fmt.Errorf("asana: GET %s: %s: %s", req.URL.Path, resp.Status, body)
+	}
+
+	var envelope struct {
+		Data     json.RawMessage `json:"data"`
+		NextPage *struct {
+			Offset string `json:"offset"`
+		} `json:"next_page"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return "", err
+	}
+
+	if c.AcceptLegacyIDs {
+		applyLegacyGID(envelope.Data, out)
+	}
+
+	if envelope.NextPage != nil {
+		nextPage = envelope.NextPage.Offset
+	}
+
+	return nextPage, nil
+}
+
+// post issues a POST request with body wrapped in the Asana {"data":
+// ...} envelope, decoding the response into out. Pass a nil out to
+// discard the response body.
+func (c *Client) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	return c.send(ctx, http.MethodPost, path, body, out)
+}
+
+// put issues a PUT request, following the same conventions as post.
+func (c *Client) put(ctx context.Context, path string, body interface{}, out interface{}) error {
+	return c.send(ctx, http.MethodPut, path, body, out)
+}
+
+// delete issues a DELETE request against path.
+func (c *Client) delete(ctx context.Context, path string) error {
+	return c.send(ctx, http.MethodDelete, path, nil, nil)
+}
+
+func (c *Client) send(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(struct {
+			Data interface{} `json:"data"`
+		}{Data: body})
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path), payload)
+	if err != nil {
+		return err
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if err := c.do(req, out); err != nil {
+		return err
+	}
+
+	// A mutating call invalidates any cached GET response for the same
+	// path, so callers never read back stale data after a write.
+	return c.InvalidateCache(path)
+}
+
+// addOptions appends opts as opt_ query parameters to path.
+func addOptions(path string, opts *Options) string {
+	if opts == nil {
+		return path
+	}
+
+	q := url.Values{}
+	if opts.Pretty {
+		q.Set("opt_pretty", "true")
+	}
+	if len(opts.Fields) > 0 {
+		q.Set("opt_fields", joinComma(opts.Fields))
+	}
+	if len(opts.Expand) > 0 {
+		q.Set("opt_expand", joinComma(opts.Expand))
+	}
+	if opts.Limit > 0 {
+		q.Set("limit", fmt.Sprintf("%d", opts.Limit))
+	}
+	if opts.Offset != "" {
+		q.Set("offset", opts.Offset)
+	}
+
+	if len(q) == 0 {
+		return path
+	}
+
+	sep := "?"
+	if bytes.ContainsRune([]byte(path), '?') {
+		sep = "&"
+	}
+	return path + sep + q.Encode()
+}
+
+func joinComma(values []string) string {
+	out := ""
+	for i, v := range values {
+		if i > 0 {
+			out += ","
+		}
+		out += v
+	}
+	return out
+}
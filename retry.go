@@ -0,0 +1,167 @@
+package asana
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry tuning, matching the values Asana itself recommends for
+// clients that hit 429s or transient 5xxs.
+const (
+	DefaultMaxRetryCount    = 3
+	DefaultMaxRetryWaitTime = 300 * time.Second
+	DefaultRetryBaseDelay   = 1 * time.Second
+)
+
+// RetryHook is called after each attempt made by a RetryTransport,
+// whether or not it will be retried, so callers can record metrics.
+type RetryHook func(req *http.Request, resp *http.Response, err error, attempt int, wait time.Duration)
+
+// RetryTransport is an http.RoundTripper that retries requests which fail
+// with a 429 or a 5xx response, or with a transport-level error, using
+// full-jitter exponential backoff. It honors the Retry-After header when
+// Asana sends one, and can be stacked on top of another RoundTripper (for
+// example one that adds authentication) via Next.
+type RetryTransport struct {
+	// Next is the underlying RoundTripper used to make the actual
+	// request. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+
+	// MaxRetries is the maximum number of attempts after the initial
+	// request. Defaults to DefaultMaxRetryCount.
+	MaxRetries int
+
+	// MaxRetryWait caps the delay between attempts. Defaults to
+	// DefaultMaxRetryWaitTime.
+	MaxRetryWait time.Duration
+
+	// RetryBaseDelay is the base used for the exponential backoff
+	// calculation. Defaults to DefaultRetryBaseDelay.
+	RetryBaseDelay time.Duration
+
+	// RetryPOST allows retrying POST requests, which are not
+	// idempotent in general. Off by default; enable it only for POSTs
+	// you know are safe to repeat (for example ones Asana de-dupes on
+	// a client-supplied key).
+	RetryPOST bool
+
+	// OnRetry, if set, is called after every attempt.
+	OnRetry RetryHook
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	maxRetries := t.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultMaxRetryCount
+	}
+	maxWait := t.MaxRetryWait
+	if maxWait == 0 {
+		maxWait = DefaultMaxRetryWaitTime
+	}
+	baseDelay := t.RetryBaseDelay
+	if baseDelay == 0 {
+		baseDelay = DefaultRetryBaseDelay
+	}
+
+	var resp *http.Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		resp, err = next.RoundTrip(req)
+
+		retryable := attempt < maxRetries && t.shouldRetry(req, resp, err)
+		if !retryable {
+			if t.OnRetry != nil {
+				t.OnRetry(req, resp, err, attempt, 0)
+			}
+			return resp, err
+		}
+
+		wait := retryAfter(resp)
+		if wait == 0 {
+			wait = fullJitterBackoff(baseDelay, maxWait, attempt)
+		}
+		if wait > maxWait {
+			wait = maxWait
+		}
+
+		if t.OnRetry != nil {
+			t.OnRetry(req, resp, err, attempt, wait)
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return resp, req.Context().Err()
+		case <-time.After(wait):
+		}
+
+		// The previous attempt's transport has already drained (and
+		// likely closed) req.Body, so it must be re-created from
+		// GetBody before the next RoundTrip or the retry would send an
+		// empty body.
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+// shouldRetry reports whether the given attempt is worth retrying.
+func (t *RetryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if !t.RetryPOST && req.Method == http.MethodPost {
+		return false
+	}
+
+	if err != nil {
+		return true
+	}
+
+	if resp == nil {
+		return false
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
+
+// retryAfter parses a Retry-After header expressed in seconds, returning
+// zero if resp is nil or the header is absent or unparseable.
+func retryAfter(resp *http.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// fullJitterBackoff implements the "full jitter" strategy described in
+// https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/:
+// sleep = random_between(0, min(cap, base * 2^attempt)).
+func fullJitterBackoff(base, cap time.Duration, attempt int) time.Duration {
+	upper := float64(base) * math.Pow(2, float64(attempt))
+	if upper > float64(cap) || upper < 0 {
+		upper = float64(cap)
+	}
+
+	return time.Duration(rand.Int63n(int64(upper) + 1))
+}
@@ -0,0 +1,63 @@
+package asana
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// sequenceRoundTripper returns its canned responses in order, recording
+// the body it actually received on each call.
+type sequenceRoundTripper struct {
+	responses []*http.Response
+	bodies    []string
+}
+
+func (s *sequenceRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, _ := io.ReadAll(req.Body)
+	s.bodies = append(s.bodies, string(body))
+
+	resp := s.responses[len(s.bodies)-1]
+	return resp, nil
+}
+
+func TestRetryTransportResendsBodyOnRetry(t *testing.T) {
+	rt := &sequenceRoundTripper{
+		responses: []*http.Response{
+			{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(bytes.NewReader(nil)), Header: http.Header{}},
+			{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(nil)), Header: http.Header{}},
+		},
+	}
+
+	transport := &RetryTransport{
+		Next:           rt,
+		MaxRetries:     1,
+		RetryBaseDelay: time.Millisecond,
+		MaxRetryWait:   time.Millisecond,
+		RetryPOST:      true,
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://example.com", bytes.NewReader([]byte("hello world")))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+
+	if len(rt.bodies) != 2 {
+		t.Fatalf("expected 2 attempts, got %d", len(rt.bodies))
+	}
+	for i, body := range rt.bodies {
+		if body != "hello world" {
+			t.Errorf("attempt %d body = %q, want %q (body was not reset before the retry)", i, body, "hello world")
+		}
+	}
+}
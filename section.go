@@ -0,0 +1,35 @@
+package asana
+
+import "context"
+
+// Section is a labeled group of tasks within a Project, used both for
+// list-view groupings and Kanban-style boards.
+type Section struct {
+	HasID
+	HasName
+	HasCreated
+
+	expandable
+
+	// Project this section belongs to.
+	Project *Project `json:"project,omitempty"`
+}
+
+// AddTask appends taskGID to the end of s.
+func (s *Section) AddTask(ctx context.Context, client *Client, taskGID string) error {
+	return client.post(ctx, "/sections/"+s.GID+"/addTask", map[string]string{"task": taskGID}, nil)
+}
+
+// InsertBefore moves taskGID so it immediately precedes beforeTaskGID
+// within s. Pass an empty beforeTaskGID to move the task to the end of
+// the section instead. There is no separate insert endpoint; Asana's
+// addTask action takes the insertion point via an optional
+// insert_before field.
+func (s *Section) InsertBefore(ctx context.Context, client *Client, taskGID, beforeTaskGID string) error {
+	body := map[string]string{"task": taskGID}
+	if beforeTaskGID != "" {
+		body["insert_before"] = beforeTaskGID
+	}
+
+	return client.post(ctx, "/sections/"+s.GID+"/addTask", body, nil)
+}
@@ -0,0 +1,43 @@
+package asana
+
+import "context"
+
+// Story is a single comment or system-generated activity record on a
+// task, for example "X added Y as a follower" or a user's own comment.
+type Story struct {
+	HasID
+	HasCreated
+	HasParent
+
+	expandable
+
+	// Type is "comment" for a user-written note, "system" for an
+	// automatically generated activity record.
+	Type string `json:"resource_subtype,omitempty"`
+
+	// Text is the comment body, empty for system stories.
+	Text string `json:"text,omitempty"`
+
+	// CreatedBy is who wrote the comment, nil for system stories.
+	CreatedBy *User `json:"created_by,omitempty"`
+}
+
+// Stories lists the comments and activity on t, oldest first.
+func (t *Task) Stories(ctx context.Context, client *Client) ([]*Story, error) {
+	var result []*Story
+	if err := client.get(ctx, "/tasks/"+t.GID+"/stories", &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CreateStory adds a comment to t.
+func (t *Task) CreateStory(ctx context.Context, client *Client, text string) (*Story, error) {
+	result := &Story{}
+	if err := client.post(ctx, "/tasks/"+t.GID+"/stories", map[string]string{"text": text}, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,38 @@
+package asana
+
+import "context"
+
+// Tag is a short, workspace-scoped label that can be attached to any
+// number of tasks to group them outside of a single project's
+// structure.
+type Tag struct {
+	HasID
+	HasName
+	HasCreated
+	HasNotes
+	HasColor
+	HasWorkspace
+	HasFollowers
+
+	expandable
+}
+
+// Tags lists every tag defined in w.
+func (w *Workspace) Tags(ctx context.Context, client *Client) ([]*Tag, error) {
+	var result []*Tag
+	if err := client.get(ctx, "/workspaces/"+w.GID+"/tags", &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AddTag attaches the tag identified by tagGID to t.
+func (t *Task) AddTag(ctx context.Context, client *Client, tagGID string) error {
+	return client.post(ctx, "/tasks/"+t.GID+"/addTag", map[string]string{"tag": tagGID}, nil)
+}
+
+// RemoveTag detaches the tag identified by tagGID from t.
+func (t *Task) RemoveTag(ctx context.Context, client *Client, tagGID string) error {
+	return client.post(ctx, "/tasks/"+t.GID+"/removeTag", map[string]string{"tag": tagGID}, nil)
+}
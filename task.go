@@ -0,0 +1,26 @@
+package asana
+
+// Task is a single item of work in Asana, the object most other
+// resources (stories, attachments, custom field values) attach to.
+type Task struct {
+	HasID
+	HasName
+	HasNotes
+	HasDates
+	HasHearts
+	HasFollowers
+	HasWorkspace
+	HasParent
+
+	expandable
+
+	// Completed is true once the task has been marked done.
+	Completed bool `json:"completed,omitempty"`
+
+	// Assignee is who the task is assigned to, nil if unassigned.
+	Assignee *User `json:"assignee,omitempty"`
+
+	// CustomFields holds the value of every custom field defined on
+	// the task's project(s), decoded according to each field's type.
+	CustomFields []*CustomFieldValue `json:"custom_fields,omitempty"`
+}
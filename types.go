@@ -35,10 +35,15 @@ func (d *Date) UnmarshalJSON(value []byte) error {
 	return nil
 }
 
-// HasID is a mixin for objects with an ID
+// HasID is a mixin for objects with an ID. Asana has moved from numeric
+// IDs to string "gid" values. HasID intentionally has no UnmarshalJSON of
+// its own: giving a mixin that method would get promoted to every
+// embedder and silently take over decoding of their other fields too.
+// The legacy numeric "id" compatibility shim instead lives in
+// Client.AcceptLegacyIDs, applied generically after the normal decode.
 type HasID struct {
-	// Read-only. Globally unique ID of the object
-	ID int64 `json:"id,omitempty"`
+	// Read-only. Globally unique ID of the object.
+	GID string `json:"gid,omitempty"`
 }
 
 // HasName is a mixin for objects with a human-readable name
@@ -167,6 +172,15 @@ type Options struct {
 	// will be called with a single argument, a JavaScript object representing
 	// the response.
 	JSONP string `json:"jsonp,omitempty" url:"opt_jsonp,omitempty"`
+
+	// Limit is the maximum number of objects to return on a single page
+	// of a paginated request. The API will never return more objects
+	// than this, though it may return fewer.
+	Limit int `json:"limit,omitempty" url:"limit,omitempty"`
+
+	// Offset is the pagination token for the next page of a paginated
+	// request, taken verbatim from the response's next_page.offset.
+	Offset string `json:"offset,omitempty" url:"offset,omitempty"`
 }
 
 // Attachment represents any file attached to a task in Asana,
@@ -0,0 +1,26 @@
+package asana
+
+// User is an individual Asana account, often the actor or target of
+// other resources (for example a task's assignee or followers).
+type User struct {
+	HasID
+	HasName
+
+	expandable
+
+	// Email is the user's login email.
+	Email string `json:"email,omitempty"`
+
+	// Photo holds avatar URLs at a few standard sizes, nil if the user
+	// has not set one.
+	Photo *UserPhoto `json:"photo,omitempty"`
+}
+
+// UserPhoto is the set of avatar image sizes Asana generates for a User.
+type UserPhoto struct {
+	Image21x21   string `json:"image_21x21,omitempty"`
+	Image27x27   string `json:"image_27x27,omitempty"`
+	Image36x36   string `json:"image_36x36,omitempty"`
+	Image60x60   string `json:"image_60x60,omitempty"`
+	Image128x128 string `json:"image_128x128,omitempty"`
+}
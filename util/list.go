@@ -1,67 +1,84 @@
 package util
 
 import (
+	"context"
 	"fmt"
 
 	"bitbucket.org/mikehouston/asana-go"
 )
 
+// defaultPageSize is the Options.Limit used when paging through list
+// endpoints on behalf of the caller.
+const defaultPageSize = 100
+
 func ListWorkspaces(c *asana.Client) error {
-	// List workspaces
-	workspaces, nextPage, err := c.Workspaces()
-	if err != nil {
-		return err
-	}
-	_ = nextPage
+	it := c.WorkspacesIterator(nil, defaultPageSize)
+
+	ctx := context.Background()
+	for {
+		workspace, err := it.Next(ctx)
+		if err == asana.ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 
-	for _, workspace := range workspaces {
 		if workspace.IsOrganization {
-			fmt.Printf("Organization %s %q\n", workspace.ID, workspace.Name)
+			fmt.Printf("Organization %s %q\n", workspace.GID, workspace.Name)
 		} else {
-			fmt.Printf("Workspace %s %q\n", workspace.ID, workspace.Name)
+			fmt.Printf("Workspace %s %q\n", workspace.GID, workspace.Name)
 		}
 	}
-	return nil
 }
 
 func ListProjects(client *asana.Client, w *asana.Workspace) error {
-	// List projects
-	projects, nextPage, err := w.Projects(client)
-	if err != nil {
-		return err
-	}
-	_ = nextPage
+	it := w.ProjectsIterator(client, nil, defaultPageSize)
+
+	ctx := context.Background()
+	for {
+		project, err := it.Next(ctx)
+		if err == asana.ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 
-	for _, project := range projects {
-		fmt.Printf("Project %s: %q\n", project.ID, project.Name)
+		fmt.Printf("Project %s: %q\n", project.GID, project.Name)
 	}
-	return nil
 }
 
 func ListTasks(client *asana.Client, p *asana.Project) error {
-	// List projects
-	tasks, nextPage, err := p.Tasks(client)
-	if err != nil {
-		return err
-	}
-	_ = nextPage
+	it := p.TasksIterator(client, nil, defaultPageSize)
+
+	ctx := context.Background()
+	for {
+		task, err := it.Next(ctx)
+		if err == asana.ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 
-	for _, task := range tasks {
-		fmt.Printf("Task %s %q\n", task.ID, task.Name)
+		fmt.Printf("Task %s %q\n", task.GID, task.Name)
 	}
-	return nil
 }
 
 func ListSections(client *asana.Client, p *asana.Project) error {
-	// List sections
-	sections, nextPage, err := p.Sections(client)
-	if err != nil {
-		return err
-	}
-	_ = nextPage
+	it := p.SectionsIterator(client, nil, defaultPageSize)
+
+	ctx := context.Background()
+	for {
+		section, err := it.Next(ctx)
+		if err == asana.ErrIteratorDone {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
 
-	for _, section := range sections {
-		fmt.Printf("Section %s %q\n", section.ID, section.Name)
+		fmt.Printf("Section %s %q\n", section.GID, section.Name)
 	}
-	return nil
 }
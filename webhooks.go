@@ -0,0 +1,142 @@
+package asana
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// Webhook is a subscription that causes Asana to push Events for changes
+// to Resource as HTTP requests to Target.
+type Webhook struct {
+	HasID
+
+	// Resource is the gid of the object being watched.
+	Resource *EventResource `json:"resource,omitempty"`
+
+	// Target is the URL Asana delivers events to.
+	Target string `json:"target,omitempty"`
+
+	// Active is false until the handshake in WebhookHandler completes.
+	Active bool `json:"active,omitempty"`
+}
+
+// CreateWebhook subscribes target to receive events for resourceGID.
+// Asana immediately sends an empty POST with an X-Hook-Secret header to
+// target as a handshake; WebhookHandler must echo that header back
+// before the webhook becomes Active.
+func (c *Client) CreateWebhook(ctx context.Context, resourceGID, target string) (*Webhook, error) {
+	body := map[string]string{
+		"resource": resourceGID,
+		"target":   target,
+	}
+
+	result := &Webhook{}
+	if err := c.post(ctx, "/webhooks", body, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListWebhooks returns the webhooks registered in workspaceGID.
+func (c *Client) ListWebhooks(ctx context.Context, workspaceGID string) ([]*Webhook, error) {
+	var result []*Webhook
+	if err := c.get(ctx, "/webhooks?workspace="+workspaceGID, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteWebhook removes a webhook subscription.
+func (c *Client) DeleteWebhook(ctx context.Context, webhookGID string) error {
+	return c.delete(ctx, "/webhooks/"+webhookGID)
+}
+
+// SecretStore looks up the shared secret captured during a webhook's
+// handshake, so WebhookHandler can validate deliveries for it.
+type SecretStore interface {
+	// Secret returns the HMAC secret for webhookGID, or false if none
+	// is known (for example because the handshake hasn't completed).
+	Secret(webhookGID string) (secret []byte, ok bool)
+
+	// SetSecret stores the secret Asana sent during the handshake for
+	// webhookGID.
+	SetSecret(webhookGID string, secret []byte)
+}
+
+// WebhookHandler is an http.Handler that completes the webhook handshake
+// and validates incoming deliveries against the secret captured during
+// it, emitting the parsed Events on Events for each valid delivery.
+type WebhookHandler struct {
+	// WebhookGID identifies which webhook this handler serves deliveries
+	// for, used to look up its secret in Secrets.
+	WebhookGID string
+
+	// Secrets stores and retrieves the per-webhook HMAC secret.
+	Secrets SecretStore
+
+	// Events receives the events from every validated delivery. The
+	// handler blocks sending to it, so callers must keep it drained.
+	Events chan<- []*Event
+}
+
+type webhookPayload struct {
+	Events []*Event `json:"events"`
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if secret := r.Header.Get("X-Hook-Secret"); secret != "" {
+		// Handshake: echo the secret back and remember it for later
+		// deliveries, per https://developers.asana.com/docs/webhooks.
+		h.Secrets.SetSecret(h.WebhookGID, []byte(secret))
+		w.Header().Set("X-Hook-Secret", secret)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	secret, ok := h.Secrets.Secret(h.WebhookGID)
+	if !ok {
+		http.Error(w, "webhook secret unknown; handshake not completed", http.StatusForbidden)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+
+	if !validSignature(secret, body, r.Header.Get("X-Hook-Signature")) {
+		http.Error(w, "invalid X-Hook-Signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	h.Events <- payload.Events
+	w.WriteHeader(http.StatusOK)
+}
+
+func validSignature(secret, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+	expected := mac.Sum(nil)
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, decoded)
+}
@@ -0,0 +1,55 @@
+package asana
+
+import "context"
+
+// Workspace is the top-level organizational unit in Asana. Organizations
+// are workspaces with IsOrganization set and a handful of extra
+// features (teams, guests, custom fields shared across the org).
+type Workspace struct {
+	HasID
+	HasName
+
+	expandable
+
+	// IsOrganization is true if this workspace is an organization.
+	IsOrganization bool `json:"is_organization,omitempty"`
+}
+
+// Workspaces lists every workspace the authenticated user belongs to.
+// The returned offset, if non-empty, should be passed back via
+// Options.Offset to fetch the next page.
+func (c *Client) Workspaces(opts *Options) (workspaces []*Workspace, nextPage string, err error) {
+	nextPage, err = c.getPage(context.Background(), addOptions("/workspaces", opts), &workspaces)
+	return workspaces, nextPage, err
+}
+
+// Projects lists the projects in w.
+func (w *Workspace) Projects(client *Client, opts *Options) (projects []*Project, nextPage string, err error) {
+	nextPage, err = client.getPage(context.Background(), addOptions("/workspaces/"+w.GID+"/projects", opts), &projects)
+	return projects, nextPage, err
+}
+
+// WorkspacesIterator returns an Iterator over every workspace the
+// authenticated user belongs to, so callers don't have to hand-roll a
+// Fetcher around Workspaces.
+func (c *Client) WorkspacesIterator(opts *Options, pageSize int) *Iterator[*Workspace] {
+	return NewIterator(func(ctx context.Context, offset string) (Page[*Workspace], error) {
+		workspaces, nextPage, err := c.Workspaces(withPage(opts, pageSize, offset))
+		if err != nil {
+			return Page[*Workspace]{}, err
+		}
+		return Page[*Workspace]{Items: workspaces, NextPage: nextPage}, nil
+	}, pageSize)
+}
+
+// ProjectsIterator returns an Iterator over the projects in w, so
+// callers don't have to hand-roll a Fetcher around Projects.
+func (w *Workspace) ProjectsIterator(client *Client, opts *Options, pageSize int) *Iterator[*Project] {
+	return NewIterator(func(ctx context.Context, offset string) (Page[*Project], error) {
+		projects, nextPage, err := w.Projects(client, withPage(opts, pageSize, offset))
+		if err != nil {
+			return Page[*Project]{}, err
+		}
+		return Page[*Project]{Items: projects, NextPage: nextPage}, nil
+	}, pageSize)
+}